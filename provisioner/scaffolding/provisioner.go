@@ -34,7 +34,9 @@ func (p *Provisioner) Prepare(raws ...interface{}) error {
 		Interpolate:        true,
 		InterpolateContext: &p.config.ctx,
 		InterpolateFilter: &interpolate.RenderFilter{
-			Exclude: []string{},
+			Exclude: []string{
+				"mock",
+			},
 		},
 	}, raws...)
 	if err != nil {
@@ -44,6 +46,15 @@ func (p *Provisioner) Prepare(raws ...interface{}) error {
 }
 
 func (p *Provisioner) Provision(_ context.Context, ui packer.Ui, _ packer.Communicator, generatedData map[string]interface{}) error {
-	ui.Say(fmt.Sprintf("provisioner mock: %s", p.config.MockOption))
+	// Build data is only known once the builder has run, so fields that may
+	// reference it are rendered here rather than in Prepare.
+	p.config.ctx.Data = generatedData
+
+	mock, err := interpolate.Render(p.config.MockOption, &p.config.ctx)
+	if err != nil {
+		return fmt.Errorf("Error interpolating mock: %s", err)
+	}
+
+	ui.Say(fmt.Sprintf("provisioner mock: %s", mock))
 	return nil
 }